@@ -0,0 +1,67 @@
+package algosort
+
+// MergeSortRecursive sorts arr using the naive recursive merge sort from Sort,
+// kept around for benchmarking against MergeSortInPlace.
+func MergeSortRecursive[T any](arr []T, less func(a, b T) bool) []T {
+	return Sort(arr, less)
+}
+
+// MergeSortInPlace sorts arr in place using an iterative bottom-up merge sort.
+// It allocates a single auxiliary buffer up front and swaps src/dst between
+// passes instead of allocating on every merge, unlike the recursive Sort.
+func MergeSortInPlace[T any](arr []T, less func(a, b T) bool) {
+	n := len(arr)
+	if n <= 1 {
+		return
+	}
+
+	buf := make([]T, n)
+	src, dst := arr, buf
+
+	for width := 1; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			mid := minInt(lo+width, n)
+			hi := minInt(lo+2*width, n)
+			mergeInto(src[lo:mid], src[mid:hi], dst[lo:hi], less)
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &arr[0] {
+		copy(arr, src)
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func mergeInto[T any](left, right, dst []T, less func(a, b T) bool) {
+	i, j, k := 0, 0, 0
+
+	for i < len(left) && j < len(right) {
+		if less(right[j], left[i]) {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+
+	for i < len(left) {
+		dst[k] = left[i]
+		i++
+		k++
+	}
+
+	for j < len(right) {
+		dst[k] = right[j]
+		j++
+		k++
+	}
+}
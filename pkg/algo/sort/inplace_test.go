@@ -0,0 +1,94 @@
+package algosort
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MergeSortInPlace(t *testing.T) {
+	fixture := []int{4, 2, 7, 1, 0}
+	expected := []int{0, 1, 2, 4, 7}
+
+	MergeSortInPlace(fixture, func(a, b int) bool { return a < b })
+
+	assert.Equal(t, expected, fixture)
+}
+
+func Test_MergeSortInPlace_EmptyAndSingle(t *testing.T) {
+	empty := []int{}
+	MergeSortInPlace(empty, func(a, b int) bool { return a < b })
+	assert.Equal(t, []int{}, empty)
+
+	single := []int{1}
+	MergeSortInPlace(single, func(a, b int) bool { return a < b })
+	assert.Equal(t, []int{1}, single)
+}
+
+func Test_MergeSortInPlace_MatchesRecursive(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	less := func(a, b int) bool { return a < b }
+
+	for i := 0; i < 20; i++ {
+		arr := make([]int, r.Intn(200))
+		for j := range arr {
+			arr[j] = r.Intn(1000)
+		}
+
+		want := MergeSortRecursive(append([]int{}, arr...), less)
+
+		got := append([]int{}, arr...)
+		MergeSortInPlace(got, less)
+
+		assert.Equal(t, want, got)
+	}
+}
+
+func randomInts(n int) []int {
+	r := rand.New(rand.NewSource(42))
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = r.Int()
+	}
+	return arr
+}
+
+func BenchmarkMergeSort(b *testing.B) {
+	less := func(a, c int) bool { return a < c }
+
+	for _, n := range []int{1e3, 1e5, 1e6} {
+		arr := randomInts(n)
+		b.Run(benchSize(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				MergeSortRecursive(append([]int{}, arr...), less)
+			}
+		})
+	}
+}
+
+func BenchmarkMergeSortInPlace(b *testing.B) {
+	less := func(a, c int) bool { return a < c }
+
+	for _, n := range []int{1e3, 1e5, 1e6} {
+		arr := randomInts(n)
+		b.Run(benchSize(n), func(b *testing.B) {
+			buf := make([]int, n)
+			for i := 0; i < b.N; i++ {
+				copy(buf, arr)
+				MergeSortInPlace(buf, less)
+			}
+		})
+	}
+}
+
+func benchSize(n int) string {
+	switch {
+	case n >= 1e6:
+		return "1e6"
+	case n >= 1e5:
+		return "1e5"
+	default:
+		return "1e3"
+	}
+}
@@ -0,0 +1,80 @@
+package algosort
+
+// NaturalLess compares a and b in "natural" order: runs of ASCII digits are
+// compared by their numeric value rather than character-by-character, so
+// "v1.2.0" < "v1.20.0" < "v1.29.0" and "2" < "12". Ties on a shared prefix
+// fall back to comparing length.
+func NaturalLess(a, b string) bool {
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		ca, cb := a[i], b[j]
+
+		if isDigit(ca) && isDigit(cb) {
+			numA, nextI := digitRun(a, i)
+			numB, nextJ := digitRun(b, j)
+
+			if cmp := compareDigitRuns(numA, numB); cmp != 0 {
+				return cmp < 0
+			}
+
+			i, j = nextI, nextJ
+			continue
+		}
+
+		if ca != cb {
+			return ca < cb
+		}
+
+		i++
+		j++
+	}
+
+	return len(a) < len(b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// digitRun returns the run of digits in s starting at i with leading zeros
+// stripped, along with the index just past the run.
+func digitRun(s string, i int) (string, int) {
+	start := i
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+
+	run := s[start:i]
+	run = stripLeadingZeros(run)
+
+	return run, i
+}
+
+func stripLeadingZeros(run string) string {
+	k := 0
+	for k < len(run)-1 && run[k] == '0' {
+		k++
+	}
+	return run[k:]
+}
+
+// compareDigitRuns compares two strings of digits (no leading zeros) by
+// length first, then lexicographically, returning -1, 0, or 1.
+func compareDigitRuns(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
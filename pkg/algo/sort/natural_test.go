@@ -0,0 +1,40 @@
+package algosort
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NaturalLess(t *testing.T) {
+	tests := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"version minor vs patch growth": {a: "v1.2.0", b: "v1.20.0", expected: true},
+		"version minor growth":          {a: "v1.20.0", b: "v1.29.0", expected: true},
+		"numeric width":                 {a: "2", b: "12", expected: true},
+		"reverse numeric width":         {a: "12", b: "2", expected: false},
+		"trailing letter differs":       {a: "a-1-a", b: "a-1-b", expected: true},
+		"embedded leading zero":         {a: "a01", b: "a1", expected: false},
+		"embedded leading zero reverse": {a: "a1", b: "a01", expected: true},
+		"empty vs non-empty":            {a: "", b: "a", expected: true},
+		"both empty":                    {a: "", b: "", expected: false},
+		"shared prefix, shorter wins":   {a: "abc", b: "abcd", expected: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NaturalLess(tt.a, tt.b))
+		})
+	}
+}
+
+func Test_Sort_WithNaturalLess(t *testing.T) {
+	tags := []string{"v1.29.0", "v1.2.0", "v1.20.0", "v1.3.0"}
+	expected := []string{"v1.2.0", "v1.3.0", "v1.20.0", "v1.29.0"}
+
+	result := Sort(tags, NaturalLess)
+
+	assert.Equal(t, expected, result)
+}
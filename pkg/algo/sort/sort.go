@@ -0,0 +1,54 @@
+// Package algosort provides a generic, comparator-driven merge sort plus
+// Ascending/Descending adapters for use with the standard library's sort.Interface.
+package algosort
+
+import "golang.org/x/exp/constraints"
+
+// Sort returns the elements of arr sorted by less, leaving arr unmodified.
+// The result is a newly allocated slice, except when arr has fewer than two
+// elements, in which case arr itself is returned.
+func Sort[T any](arr []T, less func(a, b T) bool) []T {
+	if len(arr) <= 1 {
+		return arr
+	}
+
+	mid := len(arr) / 2
+	left := Sort(arr[:mid], less)
+	right := Sort(arr[mid:], less)
+	return merge(left, right, less)
+}
+
+func merge[T any](left, right []T, less func(a, b T) bool) []T {
+	result := make([]T, 0, len(left)+len(right))
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		// left[i] stays ahead of right[j] on ties, keeping the sort stable.
+		if less(right[j], left[i]) {
+			result = append(result, right[j])
+			j++
+		} else {
+			result = append(result, left[i])
+			i++
+		}
+	}
+
+	result = append(result, left[i:]...)
+	result = append(result, right[j:]...)
+
+	return result
+}
+
+// Ascending sorts a slice of ordered values from smallest to largest via sort.Sort.
+type Ascending[T constraints.Ordered] []T
+
+func (a Ascending[T]) Len() int           { return len(a) }
+func (a Ascending[T]) Less(i, j int) bool { return a[i] < a[j] }
+func (a Ascending[T]) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+// Descending sorts a slice of ordered values from largest to smallest via sort.Sort.
+type Descending[T constraints.Ordered] []T
+
+func (d Descending[T]) Len() int           { return len(d) }
+func (d Descending[T]) Less(i, j int) bool { return d[i] > d[j] }
+func (d Descending[T]) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
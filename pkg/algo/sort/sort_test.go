@@ -0,0 +1,108 @@
+package algosort
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sort_Int(t *testing.T) {
+	fixture := []int{4, 2, 7, 1, 0}
+	expected := []int{0, 1, 2, 4, 7}
+
+	result := Sort(fixture, func(a, b int) bool { return a < b })
+
+	assert.Equal(t, expected, result)
+}
+
+type person struct {
+	name string
+	age  int
+}
+
+func Test_Sort_TableDriven(t *testing.T) {
+	tests := map[string]struct {
+		arr      []string
+		less     func(a, b string) bool
+		expected []string
+	}{
+		"strings ascending": {
+			arr:      []string{"banana", "apple", "cherry"},
+			less:     func(a, b string) bool { return a < b },
+			expected: []string{"apple", "banana", "cherry"},
+		},
+		"strings descending": {
+			arr:      []string{"banana", "apple", "cherry"},
+			less:     func(a, b string) bool { return a > b },
+			expected: []string{"cherry", "banana", "apple"},
+		},
+		"empty slice": {
+			arr:      []string{},
+			less:     func(a, b string) bool { return a < b },
+			expected: []string{},
+		},
+		"nil slice": {
+			arr:      nil,
+			less:     func(a, b string) bool { return a < b },
+			expected: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := Sort(tt.arr, tt.less)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Sort_Structs(t *testing.T) {
+	fixture := []person{
+		{name: "carol", age: 30},
+		{name: "alice", age: 25},
+		{name: "bob", age: 25},
+	}
+	expected := []person{
+		{name: "alice", age: 25},
+		{name: "bob", age: 25},
+		{name: "carol", age: 30},
+	}
+
+	result := Sort(fixture, func(a, b person) bool { return a.age < b.age })
+
+	assert.Equal(t, expected, result)
+}
+
+func Test_Sort_Stability(t *testing.T) {
+	type item struct {
+		key   int
+		order int
+	}
+
+	fixture := []item{
+		{key: 1, order: 0},
+		{key: 1, order: 1},
+		{key: 0, order: 2},
+		{key: 1, order: 3},
+		{key: 0, order: 4},
+	}
+
+	result := Sort(fixture, func(a, b item) bool { return a.key < b.key })
+
+	assert.Equal(t, []int{2, 4, 0, 1, 3}, []int{
+		result[0].order, result[1].order, result[2].order, result[3].order, result[4].order,
+	})
+}
+
+func Test_Ascending(t *testing.T) {
+	fixture := []int{4, 2, 7, 1, 0}
+	sort.Sort(Ascending[int](fixture))
+	assert.Equal(t, []int{0, 1, 2, 4, 7}, fixture)
+}
+
+func Test_Descending(t *testing.T) {
+	fixture := []int{4, 2, 7, 1, 0}
+	sort.Sort(Descending[int](fixture))
+	assert.Equal(t, []int{7, 4, 2, 1, 0}, fixture)
+}